@@ -0,0 +1,102 @@
+package survey
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// AnswerSource supplies answers for questions before Ask ever calls
+// Prompt.Prompt(), which lets a survey-based CLI run unattended in CI.
+type AnswerSource interface {
+	// Lookup returns the raw answer for the question named name, and
+	// whether a value was found at all.
+	Lookup(name string) (value string, ok bool)
+}
+
+// MapSource looks answers up in an in-memory map, keyed by question name.
+type MapSource map[string]string
+
+// Lookup implements AnswerSource.
+func (m MapSource) Lookup(name string) (string, bool) {
+	v, ok := m[name]
+	return v, ok
+}
+
+// envSource looks answers up in the environment, upper-casing the question
+// name and prefixing it.
+type envSource struct {
+	prefix string
+}
+
+// EnvSource returns an AnswerSource that looks up "<prefix><NAME>" in the
+// environment for a question named "name".
+func EnvSource(prefix string) AnswerSource {
+	return &envSource{prefix: prefix}
+}
+
+// Lookup implements AnswerSource.
+func (e *envSource) Lookup(name string) (string, bool) {
+	return os.LookupEnv(e.prefix + strings.ToUpper(name))
+}
+
+// jsonFileSource looks answers up in a JSON object loaded from disk.
+type jsonFileSource struct {
+	values map[string]string
+	err    error
+}
+
+// JSONFileSource returns an AnswerSource backed by the flat JSON object
+// stored at path, e.g. {"name": "Johnny", "confirm": "true"}. If the file
+// can't be read or parsed, every Lookup fails and the error is available
+// via the returned source's Err method.
+func JSONFileSource(path string) AnswerSource {
+	src := &jsonFileSource{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		src.err = fmt.Errorf("unable to read answer file %s: %v", path, err)
+		return src
+	}
+
+	if err := json.Unmarshal(data, &src.values); err != nil {
+		src.err = fmt.Errorf("unable to parse answer file %s: %v", path, err)
+	}
+
+	return src
+}
+
+// Lookup implements AnswerSource.
+func (j *jsonFileSource) Lookup(name string) (string, bool) {
+	if j.err != nil {
+		return "", false
+	}
+	v, ok := j.values[name]
+	return v, ok
+}
+
+// Err returns the error, if any, encountered while loading the JSON answer
+// file backing j.
+func (j *jsonFileSource) Err() error {
+	return j.err
+}
+
+// FlagLookup adapts an existing flag lookup function, such as a
+// (*flag.FlagSet)'s Lookup plus Value.String, into an AnswerSource.
+type FlagLookup func(name string) (value string, set bool)
+
+// flagSource is the AnswerSource built by FlagSource.
+type flagSource struct {
+	lookup FlagLookup
+}
+
+// FlagSource returns an AnswerSource backed by lookup.
+func FlagSource(lookup FlagLookup) AnswerSource {
+	return &flagSource{lookup: lookup}
+}
+
+// Lookup implements AnswerSource.
+func (f *flagSource) Lookup(name string) (string, bool) {
+	return f.lookup(name)
+}
@@ -0,0 +1,110 @@
+package survey
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMapSourceLookup(t *testing.T) {
+	src := MapSource{"name": "Ada"}
+
+	if v, ok := src.Lookup("name"); !ok || v != "Ada" {
+		t.Fatalf("expected (Ada, true), got (%q, %v)", v, ok)
+	}
+	if _, ok := src.Lookup("missing"); ok {
+		t.Fatal("expected ok=false for a key that isn't present")
+	}
+}
+
+func TestEnvSourceLookup(t *testing.T) {
+	t.Setenv("SURVEY_NAME", "Ada")
+
+	src := EnvSource("SURVEY_")
+	if v, ok := src.Lookup("name"); !ok || v != "Ada" {
+		t.Fatalf("expected (Ada, true), got (%q, %v)", v, ok)
+	}
+	if _, ok := src.Lookup("missing"); ok {
+		t.Fatal("expected ok=false for an unset env var")
+	}
+}
+
+func TestJSONFileSourceLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	if err := os.WriteFile(path, []byte(`{"name": "Ada"}`), 0o600); err != nil {
+		t.Fatalf("unable to write fixture: %v", err)
+	}
+
+	src := JSONFileSource(path).(*jsonFileSource)
+	if err := src.Err(); err != nil {
+		t.Fatalf("unexpected error loading answer file: %v", err)
+	}
+	if v, ok := src.Lookup("name"); !ok || v != "Ada" {
+		t.Fatalf("expected (Ada, true), got (%q, %v)", v, ok)
+	}
+}
+
+func TestJSONFileSourceMissingFile(t *testing.T) {
+	src := JSONFileSource(filepath.Join(t.TempDir(), "missing.json")).(*jsonFileSource)
+	if src.Err() == nil {
+		t.Fatal("expected an error for a missing answer file")
+	}
+	if _, ok := src.Lookup("name"); ok {
+		t.Fatal("expected Lookup to fail once loading the file has failed")
+	}
+}
+
+func TestFlagSourceLookup(t *testing.T) {
+	src := FlagSource(func(name string) (string, bool) {
+		if name == "name" {
+			return "Ada", true
+		}
+		return "", false
+	})
+
+	if v, ok := src.Lookup("name"); !ok || v != "Ada" {
+		t.Fatalf("expected (Ada, true), got (%q, %v)", v, ok)
+	}
+}
+
+func TestAskConsultsSourcesBeforePrompting(t *testing.T) {
+	p := &fakePrompt{}
+	var result string
+
+	qs := []*Question{{Name: "name", Prompt: p}}
+	err := Ask(qs, &result, WithAnswerSource(MapSource{"name": "Ada"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.idx != 0 {
+		t.Fatal("expected the answer source to satisfy the question without ever prompting")
+	}
+}
+
+func TestAskFallsBackToPromptingWhenNoSourceMatches(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"Ada"}}
+	var result string
+
+	qs := []*Question{{Name: "name", Prompt: p}}
+	err := Ask(qs, &result, WithAnswerSource(MapSource{"other": "value"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.idx != 1 {
+		t.Fatal("expected Ask to fall back to prompting when no source matches")
+	}
+}
+
+func TestWithNonInteractiveErrorsWithoutASatisfyingSource(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"Ada"}}
+	var result string
+
+	qs := []*Question{{Name: "name", Prompt: p}}
+	err := Ask(qs, &result, WithNonInteractive(true))
+	if err == nil {
+		t.Fatal("expected an error instead of prompting in non-interactive mode")
+	}
+	if p.idx != 0 {
+		t.Fatal("expected non-interactive mode to never call Prompt")
+	}
+}
@@ -0,0 +1,110 @@
+package survey
+
+import "testing"
+
+func TestWhenFalseSkipsQuestionWithoutPrompting(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"unused"}}
+	var result string
+
+	qs := []*Question{{
+		Name:   "skipped",
+		Prompt: p,
+		When:   func(answers interface{}) bool { return false },
+	}}
+
+	if err := Ask(qs, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.idx != 0 {
+		t.Fatal("expected When returning false to skip prompting entirely")
+	}
+}
+
+func TestWhenTrueAsksTheQuestion(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"asked"}}
+	var result string
+
+	qs := []*Question{{
+		Name:   "asked",
+		Prompt: p,
+		When:   func(answers interface{}) bool { return true },
+	}}
+
+	if err := Ask(qs, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.idx != 1 {
+		t.Fatal("expected When returning true to prompt as usual")
+	}
+}
+
+func TestSkipErrorAbortsAsk(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"unused"}}
+	var result string
+
+	qs := []*Question{{
+		Name:   "q",
+		Prompt: p,
+		Skip:   func(answers interface{}) error { return errString("aborted") },
+	}}
+
+	if err := Ask(qs, &result); err == nil {
+		t.Fatal("expected Skip's error to abort Ask")
+	}
+	if p.idx != 0 {
+		t.Fatal("expected Skip's error to prevent prompting")
+	}
+}
+
+func TestWhenAndDefaultReadBackAnEarlierQuestionsAnswer(t *testing.T) {
+	type answers struct {
+		Color string `survey:"color"`
+		Sky   string `survey:"sky"`
+	}
+
+	colorPrompt := &fakePrompt{answers: []interface{}{"blue"}}
+	skyPrompt := &fakeDefaultPrompt{fakePrompt: fakePrompt{answers: []interface{}{"clear"}}}
+
+	qs := []*Question{
+		{Name: "color", Prompt: colorPrompt},
+		{
+			Name:   "sky",
+			Prompt: skyPrompt,
+			When: func(ans interface{}) bool {
+				return ans.(answers).Color == "blue"
+			},
+			Default: func(ans interface{}) interface{} {
+				return ans.(answers).Color + " sky"
+			},
+		},
+	}
+
+	var result answers
+	if err := Ask(qs, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if skyPrompt.idx != 1 {
+		t.Fatal("expected When to read the first question's answer and let the second question be asked")
+	}
+	if skyPrompt.gotDefault != "blue sky" {
+		t.Fatalf("expected Default to read the first question's answer through currentAnswers, got %v", skyPrompt.gotDefault)
+	}
+}
+
+func TestDefaultIsAppliedBeforePrompting(t *testing.T) {
+	p := &fakeDefaultPrompt{fakePrompt: fakePrompt{answers: []interface{}{"answer"}}}
+	var result string
+
+	qs := []*Question{{
+		Name:    "q",
+		Prompt:  p,
+		Default: func(answers interface{}) interface{} { return "computed-default" },
+	}}
+
+	if err := Ask(qs, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.gotDefault != "computed-default" {
+		t.Fatalf("expected SetDefault to be called with the computed default, got %v", p.gotDefault)
+	}
+}
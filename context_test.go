@@ -0,0 +1,73 @@
+package survey
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestAskContextAbortsWhenCtxIsAlreadyDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := &fakeCtxPrompt{}
+	var result string
+
+	err := AskContext(ctx, []*Question{{Name: "q", Prompt: p}}, &result)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestAskOneContextPropagatesCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	p := &fakeCtxPrompt{}
+	var result string
+
+	err := AskOneContext(ctx, p, &result, nil, nil)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestAskContextReturnsErrInterruptOnSIGINT(t *testing.T) {
+	p := &fakeCtxPrompt{}
+	var result string
+
+	done := make(chan error, 1)
+	go func() {
+		done <- AskContext(context.Background(), []*Question{{Name: "q", Prompt: p}}, &result)
+	}()
+
+	// give the goroutine above a chance to register its cleaner with the
+	// interrupt handler before we raise the signal
+	time.Sleep(20 * time.Millisecond)
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGINT); err != nil {
+		t.Fatalf("failed to raise SIGINT: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != ErrInterrupt {
+			t.Fatalf("expected ErrInterrupt, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("AskContext did not return after SIGINT")
+	}
+}
+
+func TestAskContextPlainPromptCompletesWhileCtxIsLive(t *testing.T) {
+	// a Prompt that doesn't implement PromptContext falls back to Prompt()
+	// and completes normally as long as ctx isn't done
+	p := &fakePrompt{answers: []interface{}{"answer"}}
+	var result string
+
+	if err := AskContext(context.Background(), []*Question{{Name: "q", Prompt: p}}, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package survey
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// ErrInterrupt is returned by AskContext/AskOneContext when the user sends
+// SIGINT (Ctrl-C) mid-question.
+var ErrInterrupt = newInterruptError()
+
+type interruptError struct{}
+
+func newInterruptError() error {
+	return &interruptError{}
+}
+
+func (e *interruptError) Error() string {
+	return "interrupt"
+}
+
+// cleaner restores whatever terminal state a Prompt put in place (echo,
+// cursor, raw mode, ...) so that Ctrl-C mid-question never leaves the
+// user's terminal wedged.
+type cleaner func()
+
+// interruptHandler multiplexes SIGINT across every Prompt that is currently
+// active. While at least one cleaner is registered, SIGINT runs every
+// registered cleaner instead of terminating the process; once the last one
+// is unregistered, SIGINT's default handling is restored.
+type interruptHandler struct {
+	mu       sync.Mutex
+	cleaners map[int]cleaner
+	nextID   int
+	sig      chan os.Signal
+	started  bool
+}
+
+var interrupts = &interruptHandler{
+	cleaners: map[int]cleaner{},
+	sig:      make(chan os.Signal, 1),
+}
+
+// registerCleaner registers c to run if SIGINT arrives while it is active,
+// and returns a function that unregisters it once the prompt it guards has
+// finished.
+func (h *interruptHandler) registerCleaner(c cleaner) func() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.start()
+	if len(h.cleaners) == 0 {
+		signal.Notify(h.sig, os.Interrupt)
+	}
+
+	id := h.nextID
+	h.nextID++
+	h.cleaners[id] = c
+
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.cleaners, id)
+		if len(h.cleaners) == 0 {
+			signal.Stop(h.sig)
+		}
+	}
+}
+
+// start starts, exactly once, the goroutine that runs the registered
+// cleaners whenever SIGINT is delivered to h.sig.
+func (h *interruptHandler) start() {
+	if h.started {
+		return
+	}
+	h.started = true
+
+	go func() {
+		for range h.sig {
+			h.mu.Lock()
+			for _, c := range h.cleaners {
+				c()
+			}
+			h.mu.Unlock()
+		}
+	}()
+}
+
+// RegisterCleaner registers c to run, restoring terminal state, if SIGINT
+// arrives while a Prompt is active. It returns a function that must be
+// called once the prompt is done to unregister c.
+func RegisterCleaner(c func()) func() {
+	return interrupts.registerCleaner(c)
+}
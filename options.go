@@ -0,0 +1,141 @@
+package survey
+
+import (
+	"io"
+
+	"github.com/AlecAivazis/survey/terminal"
+)
+
+// askOptions holds the result of applying a set of AskOpts, and is threaded
+// down into a Prompt's PromptWithOpts() call, in place of Prompt(), whenever
+// the prompt implements PromptWithOpts.
+type askOptions struct {
+	Validators     []Validator
+	Converter      Converter
+	Icons          func(*IconSet)
+	Stdio          terminal.Stdio
+	KeepFilter     *bool
+	PageSize       int
+	HelpInput      rune
+	Sources        []AnswerSource
+	NonInteractive bool
+}
+
+// AskOpt lets you configure a call to Ask or AskOne.
+type AskOpt func(*askOptions) error
+
+// Icon is a single glyph/format pair used to render a piece of prompt
+// chrome, e.g. the "?" question marker or the "X" error marker.
+type Icon struct {
+	Text   string
+	Format string
+}
+
+// IconSet groups together the glyphs a Prompt draws around a question, so
+// that WithIcons can override all of them for a single call to Ask/AskOne.
+type IconSet struct {
+	Question    Icon
+	Help        Icon
+	Error       Icon
+	SelectFocus Icon
+}
+
+// PromptWithOpts is implemented by Prompt types that want to honor the
+// stdio/icons/page-size/keep-filter options passed to Ask, in addition to
+// the plain Prompt() contract.
+type PromptWithOpts interface {
+	PromptWithOpts(opts askOptions) (interface{}, error)
+}
+
+// WithValidator adds a Validator to the list run against the answer. Unlike
+// setting Question.Validate directly, WithValidator can be called more than
+// once per question; all validators must pass, and the first failure wins.
+func WithValidator(v Validator) AskOpt {
+	return func(options *askOptions) error {
+		options.Validators = append(options.Validators, v)
+		return nil
+	}
+}
+
+// WithConverter sets the Converter used to transform the raw answer before
+// validation and before it is written back.
+func WithConverter(c Converter) AskOpt {
+	return func(options *askOptions) error {
+		options.Converter = c
+		return nil
+	}
+}
+
+// WithIcons records an override of the question, help, error, and
+// select-focus glyphs for a single call to Ask/AskOne, and hands it to any
+// Prompt that implements PromptWithOpts. None of the Prompt types in this
+// package draw icons, so they ignore it.
+func WithIcons(setIcons func(*IconSet)) AskOpt {
+	return func(options *askOptions) error {
+		options.Icons = setIcons
+		return nil
+	}
+}
+
+// WithStdio redirects the terminal I/O used for a single call to Ask/AskOne,
+// which is primarily useful for testing prompts without a real terminal.
+// The Prompt types in this package read from in and write to out; err is
+// not used by them, and they report errors on os.Stderr.
+func WithStdio(in terminal.FileReader, out terminal.FileWriter, err io.Writer) AskOpt {
+	return func(options *askOptions) error {
+		options.Stdio = terminal.Stdio{In: in, Out: out, Err: err}
+		return nil
+	}
+}
+
+// WithKeepFilter records whether a Select/MultiSelect keeps its filter text
+// around after the user picks an option, for a Prompt that implements
+// PromptWithOpts. The Select and MultiSelect in this package have no filter
+// and ignore it.
+func WithKeepFilter(keepFilter bool) AskOpt {
+	return func(options *askOptions) error {
+		options.KeepFilter = &keepFilter
+		return nil
+	}
+}
+
+// WithPageSize records the number of options a Select/MultiSelect shows at
+// once, for a Prompt that implements PromptWithOpts. The Select and
+// MultiSelect in this package always list every option and ignore it.
+func WithPageSize(pageSize int) AskOpt {
+	return func(options *askOptions) error {
+		options.PageSize = pageSize
+		return nil
+	}
+}
+
+// WithHelpInput records the rune that toggles a prompt's help text, for a
+// Prompt that implements PromptWithOpts. The Prompt types in this package
+// never show their Help text and ignore it.
+func WithHelpInput(r rune) AskOpt {
+	return func(options *askOptions) error {
+		options.HelpInput = r
+		return nil
+	}
+}
+
+// WithAnswerSource adds an AnswerSource to consult for an answer before
+// Ask falls back to prompting interactively. Sources are consulted in the
+// order they were added.
+func WithAnswerSource(s AnswerSource) AskOpt {
+	return func(options *askOptions) error {
+		options.Sources = append(options.Sources, s)
+		return nil
+	}
+}
+
+// WithNonInteractive makes Ask return an error instead of prompting
+// whenever a question's answer isn't satisfied by one of its AnswerSources.
+// This is meant for CI usage of tools built on survey, where there is no
+// terminal to prompt on in the first place.
+func WithNonInteractive(nonInteractive bool) AskOpt {
+	return func(options *askOptions) error {
+		options.NonInteractive = nonInteractive
+		return nil
+	}
+}
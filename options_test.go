@@ -0,0 +1,133 @@
+package survey
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestAskOptsComposeValidators(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"valid"}}
+	var result string
+
+	err := AskOne(p, &result, nil, nil, WithValidator(MinLength(3)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(p.errored) != 0 {
+		t.Fatalf("expected no validation errors, got %d", len(p.errored))
+	}
+	if len(p.cleaned) != 1 || p.cleaned[0] != "valid" {
+		t.Fatalf("expected Cleanup to be called with the valid answer, got %v", p.cleaned)
+	}
+}
+
+func TestAskOptsValidatorReportsFailureToThePrompt(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"x"}}
+	var result string
+
+	err := AskOne(p, &result, nil, nil, WithValidator(MinLength(3)))
+	if err == nil {
+		t.Fatal("expected an error once the prompt runs out of scripted answers to retry with")
+	}
+	if len(p.errored) != 1 {
+		t.Fatalf("expected the validation failure to be reported via Prompt.Error, got %d calls", len(p.errored))
+	}
+}
+
+func TestAskOptsValidatorAcceptsAGoodRetryAfterABadAnswer(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"x", "valid"}}
+	var result string
+
+	err := AskOne(p, &result, nil, nil, WithValidator(MinLength(3)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != "valid" {
+		t.Fatalf("expected the retried answer to be written, got %q", result)
+	}
+	if len(p.errored) != 1 {
+		t.Fatalf("expected exactly one validation failure to be reported, got %d", len(p.errored))
+	}
+}
+
+func TestAskOptsValidatorRetryRepromptsWhenTheRetryFailsToConvert(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"-5", "not-a-number", "42"}}
+	var result int
+
+	toInt := func(ans interface{}) (interface{}, error) {
+		s, _ := ans.(string)
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", s)
+		}
+		return n, nil
+	}
+
+	isPositive := func(ans interface{}) error {
+		if n, _ := ans.(int); n <= 0 {
+			return fmt.Errorf("must be positive")
+		}
+		return nil
+	}
+
+	err := AskOne(p, &result, isPositive, toInt, WithValidator(Required))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != 42 {
+		t.Fatalf("expected the eventual valid answer to be written, got %d", result)
+	}
+	if len(p.errored) != 2 {
+		t.Fatalf("expected the convert failure and the validate failure to both be reported, got %d", len(p.errored))
+	}
+}
+
+func TestAskOptsValidatorAndQuestionValidateBothApply(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"toolong"}}
+	var result string
+
+	err := AskOne(p, &result, MaxLength(3), nil, WithValidator(Required))
+	if err == nil {
+		t.Fatal("expected the Question.Validate failure to surface even though the WithValidator check passed")
+	}
+}
+
+func TestWithConverterFallsBackWhenQuestionHasNone(t *testing.T) {
+	p := &fakePrompt{answers: []interface{}{"5"}}
+	var result string
+	var converted interface{}
+
+	convert := func(ans interface{}) (interface{}, error) {
+		converted = ans
+		return ans, nil
+	}
+
+	if err := AskOne(p, &result, nil, nil, WithConverter(convert)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if converted != "5" {
+		t.Fatalf("expected WithConverter to run when Question.Convert is nil, got %v", converted)
+	}
+}
+
+func TestPromptWithOptsReceivesAskOpts(t *testing.T) {
+	p := &fakeOptsPrompt{fakePrompt: fakePrompt{answers: []interface{}{"ok"}}}
+	var result string
+
+	err := AskOne(p, &result, nil, nil, WithPageSize(7), WithHelpInput('?'))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !p.gotOptions {
+		t.Fatal("expected PromptWithOpts to be called instead of Prompt")
+	}
+	if p.lastOptions.PageSize != 7 {
+		t.Errorf("expected PageSize 7, got %d", p.lastOptions.PageSize)
+	}
+	if p.lastOptions.HelpInput != '?' {
+		t.Errorf("expected HelpInput '?', got %q", p.lastOptions.HelpInput)
+	}
+}
@@ -0,0 +1,141 @@
+package survey
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/AlecAivazis/survey/terminal"
+)
+
+// Prompter is a high level, typed facade over Ask/AskOne. It exists so that
+// CLIs built on top of this package don't have to hand-roll
+// `AskOne(&survey.Input{...}, &answer, ...)` boilerplate at every call site.
+// Because it's an interface, a CLI can also substitute its own
+// implementation in tests instead of constructing one via NewPrompter.
+type Prompter interface {
+	// Select asks the user to choose one of opts and returns its index.
+	Select(msg, help string, opts []string) (int, error)
+	// MultiSelect asks the user to choose any number of opts and returns
+	// their indices.
+	MultiSelect(msg, help string, opts []string) ([]int, error)
+	// Input asks for a single line of text, falling back to def when the
+	// user submits an empty response.
+	Input(msg, def string) (string, error)
+	// Password asks for a single line of text. The Prompter returned by
+	// NewPrompter reads it as a plain line and does not turn off echo.
+	Password(msg string) (string, error)
+	// Confirm asks a yes/no question, falling back to def when the user
+	// submits an empty response.
+	Confirm(msg string, def bool) (bool, error)
+	// Editor collects a free-form response, falling back to def when the
+	// user submits an empty one; a blank result is rejected unless
+	// blankAllowed. The Prompter returned by NewPrompter reads a single
+	// line rather than opening an editor.
+	Editor(msg, def string, blankAllowed bool) (string, error)
+	// ConfirmDeletion asks the user to type target back in order to
+	// confirm a destructive action.
+	ConfirmDeletion(target string) error
+}
+
+// prompter is the default Prompter implementation, backed by the Prompt
+// types in this package.
+type prompter struct {
+	stdin  terminal.FileReader
+	stdout terminal.FileWriter
+	stderr io.Writer
+}
+
+// NewPrompter returns a Prompter that reads from stdin and writes to stdout
+// and stderr.
+func NewPrompter(stdin terminal.FileReader, stdout terminal.FileWriter, stderr io.Writer) Prompter {
+	return &prompter{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+	}
+}
+
+// stdio returns the AskOpt that redirects a prompt to p's stdin/stdout/
+// stderr, so that a Prompter built via NewPrompter reads and writes the
+// prompt itself through them. Validation errors are still reported on
+// os.Stderr by the Prompt types' Error methods, not on p's stderr.
+func (p *prompter) stdio() AskOpt {
+	return WithStdio(p.stdin, p.stdout, p.stderr)
+}
+
+func (p *prompter) Select(msg, help string, opts []string) (int, error) {
+	var result int
+	err := AskOne(&Select{
+		Message: msg,
+		Help:    help,
+		Options: opts,
+	}, &result, nil, nil, p.stdio())
+	return result, err
+}
+
+func (p *prompter) MultiSelect(msg, help string, opts []string) ([]int, error) {
+	var result []int
+	err := AskOne(&MultiSelect{
+		Message: msg,
+		Help:    help,
+		Options: opts,
+	}, &result, nil, nil, p.stdio())
+	return result, err
+}
+
+func (p *prompter) Input(msg, def string) (string, error) {
+	var result string
+	err := AskOne(&Input{
+		Message: msg,
+		Default: def,
+	}, &result, nil, nil, p.stdio())
+	return result, err
+}
+
+func (p *prompter) Password(msg string) (string, error) {
+	var result string
+	err := AskOne(&Password{
+		Message: msg,
+	}, &result, nil, nil, p.stdio())
+	return result, err
+}
+
+func (p *prompter) Confirm(msg string, def bool) (bool, error) {
+	var result bool
+	err := AskOne(&Confirm{
+		Message: msg,
+		Default: def,
+	}, &result, nil, nil, p.stdio())
+	return result, err
+}
+
+func (p *prompter) Editor(msg, def string, blankAllowed bool) (string, error) {
+	var result string
+	err := AskOne(&Editor{
+		Message:       msg,
+		Default:       def,
+		AppendDefault: true,
+		BlankAllowed:  blankAllowed,
+	}, &result, nil, nil, p.stdio())
+	return result, err
+}
+
+// confirmDeletionValidator rejects any answer other than target.
+func confirmDeletionValidator(target string) Validator {
+	return func(ans interface{}) error {
+		if s, _ := ans.(string); s != target {
+			return fmt.Errorf("you entered %q, expected %q", s, target)
+		}
+		return nil
+	}
+}
+
+// ConfirmDeletion asks the user to type target back verbatim before
+// continuing, which guards against an accidental Enter on a plain yes/no
+// prompt for a destructive action.
+func (p *prompter) ConfirmDeletion(target string) error {
+	var result string
+	return AskOne(&Input{
+		Message: fmt.Sprintf("Type %q to confirm deletion:", target),
+	}, &result, confirmDeletionValidator(target), nil, p.stdio())
+}
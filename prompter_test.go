@@ -0,0 +1,23 @@
+package survey
+
+import "testing"
+
+var _ Prompter = (*prompter)(nil)
+
+func TestConfirmDeletionValidatorAcceptsExactMatch(t *testing.T) {
+	if err := confirmDeletionValidator("my-resource")("my-resource"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestConfirmDeletionValidatorRejectsMismatch(t *testing.T) {
+	if err := confirmDeletionValidator("my-resource")("not-it"); err == nil {
+		t.Fatal("expected an error when the typed value doesn't match the target")
+	}
+}
+
+func TestConfirmDeletionValidatorRejectsNonString(t *testing.T) {
+	if err := confirmDeletionValidator("my-resource")(42); err == nil {
+		t.Fatal("expected an error for a non-string answer")
+	}
+}
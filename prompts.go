@@ -0,0 +1,380 @@
+package survey
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// promptStdio resolves the reader/writer a Prompt should use: whatever
+// WithStdio redirected it to, or the process's real stdin/stdout otherwise.
+func promptStdio(opts askOptions) (io.Reader, io.Writer) {
+	in := io.Reader(os.Stdin)
+	if opts.Stdio.In != nil {
+		in = opts.Stdio.In
+	}
+	out := io.Writer(os.Stdout)
+	if opts.Stdio.Out != nil {
+		out = opts.Stdio.Out
+	}
+	return in, out
+}
+
+// readLine reads a single newline-terminated line from r a byte at a time.
+// Prompt implementations are read from repeatedly (once per validator
+// retry), so this avoids the bufio.Reader trap of buffering past the line
+// it was asked for and losing whatever came after it on the next read.
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := r.Read(b)
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", err
+		}
+	}
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
+
+// parseSelectChoice resolves line against options, accepting either a
+// 1-based position or the option text itself.
+func parseSelectChoice(line string, options []string) (int, error) {
+	if n, err := strconv.Atoi(line); err == nil {
+		if n < 1 || n > len(options) {
+			return 0, fmt.Errorf("%d is not a valid choice", n)
+		}
+		return n - 1, nil
+	}
+	for i, opt := range options {
+		if opt == line {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%q is not one of the available choices", line)
+}
+
+// Select asks the user to choose exactly one of Options, identified either
+// by its 1-based position or by typing the option text back. It's a
+// minimal, line-based Prompt: no raw terminal mode, no arrow-key paging.
+type Select struct {
+	Message string
+	Help    string
+	Options []string
+}
+
+func (s *Select) Prompt() (interface{}, error) {
+	in, out := promptStdio(askOptions{})
+	return s.prompt(in, out)
+}
+
+func (s *Select) PromptWithOpts(opts askOptions) (interface{}, error) {
+	in, out := promptStdio(opts)
+	return s.prompt(in, out)
+}
+
+func (s *Select) prompt(in io.Reader, out io.Writer) (interface{}, error) {
+	fmt.Fprintln(out, s.Message)
+	for i, opt := range s.Options {
+		fmt.Fprintf(out, "  %d) %s\n", i+1, opt)
+	}
+
+	for {
+		line, err := readLine(in)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := parseSelectChoice(strings.TrimSpace(line), s.Options)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			continue
+		}
+		return idx, nil
+	}
+}
+
+func (s *Select) Cleanup(interface{}) error { return nil }
+
+func (s *Select) Error(err error) error {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return nil
+}
+
+// MultiSelect asks the user to choose any number of Options, as a
+// comma-separated list of 1-based positions or option text, and returns
+// their indices.
+type MultiSelect struct {
+	Message string
+	Help    string
+	Options []string
+}
+
+func (m *MultiSelect) Prompt() (interface{}, error) {
+	in, out := promptStdio(askOptions{})
+	return m.prompt(in, out)
+}
+
+func (m *MultiSelect) PromptWithOpts(opts askOptions) (interface{}, error) {
+	in, out := promptStdio(opts)
+	return m.prompt(in, out)
+}
+
+func (m *MultiSelect) prompt(in io.Reader, out io.Writer) (interface{}, error) {
+	fmt.Fprintln(out, m.Message)
+	for i, opt := range m.Options {
+		fmt.Fprintf(out, "  %d) %s\n", i+1, opt)
+	}
+	fmt.Fprintln(out, "enter a comma-separated list of choices, or leave blank for none")
+
+	for {
+		line, err := readLine(in)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(line) == "" {
+			return []int{}, nil
+		}
+
+		fields := strings.Split(line, ",")
+		result := make([]int, 0, len(fields))
+		invalid := false
+		for _, field := range fields {
+			idx, err := parseSelectChoice(strings.TrimSpace(field), m.Options)
+			if err != nil {
+				fmt.Fprintln(out, err)
+				invalid = true
+				break
+			}
+			result = append(result, idx)
+		}
+		if invalid {
+			continue
+		}
+		return result, nil
+	}
+}
+
+func (m *MultiSelect) Cleanup(interface{}) error { return nil }
+
+func (m *MultiSelect) Error(err error) error {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return nil
+}
+
+// Input asks for a single line of text, falling back to Default when the
+// user submits an empty response.
+type Input struct {
+	Message string
+	Default string
+	Help    string
+}
+
+func (i *Input) Prompt() (interface{}, error) {
+	in, out := promptStdio(askOptions{})
+	return i.prompt(in, out)
+}
+
+func (i *Input) PromptWithOpts(opts askOptions) (interface{}, error) {
+	in, out := promptStdio(opts)
+	return i.prompt(in, out)
+}
+
+func (i *Input) prompt(in io.Reader, out io.Writer) (interface{}, error) {
+	if i.Default != "" {
+		fmt.Fprintf(out, "%s (%s) ", i.Message, i.Default)
+	} else {
+		fmt.Fprintf(out, "%s ", i.Message)
+	}
+
+	line, err := readLine(in)
+	if err != nil {
+		return nil, err
+	}
+	if line == "" {
+		return i.Default, nil
+	}
+	return line, nil
+}
+
+func (i *Input) Cleanup(interface{}) error { return nil }
+
+func (i *Input) Error(err error) error {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return nil
+}
+
+// SetDefault implements DefaultSetter, so a Question.Default can depend on
+// answers collected earlier in the same Ask call.
+func (i *Input) SetDefault(def interface{}) {
+	if d, ok := def.(string); ok {
+		i.Default = d
+	}
+}
+
+// Password asks for a single line of text. It never echoes its own Default,
+// since one shouldn't exist for a secret.
+type Password struct {
+	Message string
+	Help    string
+}
+
+func (p *Password) Prompt() (interface{}, error) {
+	in, out := promptStdio(askOptions{})
+	return p.prompt(in, out)
+}
+
+func (p *Password) PromptWithOpts(opts askOptions) (interface{}, error) {
+	in, out := promptStdio(opts)
+	return p.prompt(in, out)
+}
+
+func (p *Password) prompt(in io.Reader, out io.Writer) (interface{}, error) {
+	fmt.Fprintf(out, "%s ", p.Message)
+	return readLine(in)
+}
+
+func (p *Password) Cleanup(interface{}) error { return nil }
+
+func (p *Password) Error(err error) error {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return nil
+}
+
+// Confirm asks a yes/no question, falling back to Default when the user
+// submits an empty response.
+type Confirm struct {
+	Message string
+	Default bool
+	Help    string
+}
+
+func (c *Confirm) Prompt() (interface{}, error) {
+	in, out := promptStdio(askOptions{})
+	return c.prompt(in, out)
+}
+
+func (c *Confirm) PromptWithOpts(opts askOptions) (interface{}, error) {
+	in, out := promptStdio(opts)
+	return c.prompt(in, out)
+}
+
+func (c *Confirm) prompt(in io.Reader, out io.Writer) (interface{}, error) {
+	hint := "y/N"
+	if c.Default {
+		hint = "Y/n"
+	}
+
+	for {
+		fmt.Fprintf(out, "%s (%s) ", c.Message, hint)
+		line, err := readLine(in)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "":
+			return c.Default, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		default:
+			fmt.Fprintf(out, "%q is not a valid yes/no answer\n", line)
+		}
+	}
+}
+
+func (c *Confirm) Cleanup(interface{}) error { return nil }
+
+func (c *Confirm) Error(err error) error {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return nil
+}
+
+// SetDefault implements DefaultSetter.
+func (c *Confirm) SetDefault(def interface{}) {
+	if d, ok := def.(bool); ok {
+		c.Default = d
+	}
+}
+
+// Editor is a line-based stand-in for opening the user's preferred editor:
+// it reads a single line rather than piloting a real $EDITOR subprocess.
+// When AppendDefault is set, an empty line falls back to Default; the
+// result must be non-empty unless BlankAllowed is set.
+type Editor struct {
+	Message       string
+	Default       string
+	Help          string
+	AppendDefault bool
+	BlankAllowed  bool
+}
+
+func (e *Editor) Prompt() (interface{}, error) {
+	in, out := promptStdio(askOptions{})
+	return e.prompt(in, out)
+}
+
+func (e *Editor) PromptWithOpts(opts askOptions) (interface{}, error) {
+	in, out := promptStdio(opts)
+	return e.prompt(in, out)
+}
+
+func (e *Editor) prompt(in io.Reader, out io.Writer) (interface{}, error) {
+	for {
+		if e.Default != "" {
+			fmt.Fprintf(out, "%s (%s) ", e.Message, e.Default)
+		} else {
+			fmt.Fprintf(out, "%s ", e.Message)
+		}
+
+		line, err := readLine(in)
+		if err != nil {
+			return nil, err
+		}
+		if line == "" && e.AppendDefault {
+			line = e.Default
+		}
+		if line == "" && !e.BlankAllowed {
+			fmt.Fprintln(out, "a blank response isn't allowed")
+			continue
+		}
+		return line, nil
+	}
+}
+
+func (e *Editor) Cleanup(interface{}) error { return nil }
+
+func (e *Editor) Error(err error) error {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return nil
+}
+
+// SetDefault implements DefaultSetter.
+func (e *Editor) SetDefault(def interface{}) {
+	if d, ok := def.(string); ok {
+		e.Default = d
+	}
+}
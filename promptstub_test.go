@@ -0,0 +1,71 @@
+package survey
+
+import (
+	"context"
+	"fmt"
+)
+
+// fakePrompt is a minimal Prompt used to drive Ask/AskOne in tests without a
+// real terminal: it hands back answers in order and records what it was
+// told to Cleanup/Error.
+type fakePrompt struct {
+	answers []interface{}
+	idx     int
+	cleaned []interface{}
+	errored []error
+}
+
+func (p *fakePrompt) Prompt() (interface{}, error) {
+	if p.idx >= len(p.answers) {
+		return nil, fmt.Errorf("fakePrompt: no more scripted answers")
+	}
+	ans := p.answers[p.idx]
+	p.idx++
+	return ans, nil
+}
+
+func (p *fakePrompt) Cleanup(ans interface{}) error {
+	p.cleaned = append(p.cleaned, ans)
+	return nil
+}
+
+func (p *fakePrompt) Error(err error) error {
+	p.errored = append(p.errored, err)
+	return nil
+}
+
+// fakeOptsPrompt records the askOptions it was last called with, so tests
+// can assert that AskOpts actually reach the prompt via PromptWithOpts.
+type fakeOptsPrompt struct {
+	fakePrompt
+	lastOptions askOptions
+	gotOptions  bool
+}
+
+func (p *fakeOptsPrompt) PromptWithOpts(opts askOptions) (interface{}, error) {
+	p.lastOptions = opts
+	p.gotOptions = true
+	return p.fakePrompt.Prompt()
+}
+
+// fakeCtxPrompt blocks until ctx is done, so tests can assert that
+// AskContext/AskOneContext actually propagate cancellation into the prompt
+// read instead of blocking forever.
+type fakeCtxPrompt struct {
+	fakePrompt
+}
+
+func (p *fakeCtxPrompt) PromptContext(ctx context.Context) (interface{}, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// fakeDefaultPrompt records the default it was given via SetDefault.
+type fakeDefaultPrompt struct {
+	fakePrompt
+	gotDefault interface{}
+}
+
+func (p *fakeDefaultPrompt) SetDefault(def interface{}) {
+	p.gotDefault = def
+}
@@ -1,7 +1,11 @@
 package survey
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
 
 	"github.com/AlecAivazis/survey/core"
 )
@@ -18,6 +22,25 @@ type Question struct {
 	Prompt   Prompt
 	Validate Validator
 	Convert  Converter
+
+	// When, if set, is consulted with the answers collected so far; the
+	// question is skipped entirely (recording no answer) when it returns
+	// false.
+	When func(answers interface{}) bool
+	// Skip, if set, is consulted with the answers collected so far before
+	// When; returning an error aborts Ask.
+	Skip func(answers interface{}) error
+	// Default, if set, is consulted with the answers collected so far to
+	// compute this question's default, for Prompt implementations that
+	// support being given one after construction (see DefaultSetter).
+	Default func(answers interface{}) interface{}
+}
+
+// DefaultSetter is implemented by Prompt types whose default value can be
+// set after construction, so that Question.Default can depend on answers
+// collected earlier in the same Ask call.
+type DefaultSetter interface {
+	SetDefault(interface{})
 }
 
 // Prompt is the primary interface for the objects that can take user input
@@ -28,18 +51,42 @@ type Prompt interface {
 	Error(error) error
 }
 
-// AskOne asks a single question without performing validation on the answer.
-func AskOne(p Prompt, t interface{}, v Validator, c Converter) error {
-	err := Ask([]*Question{{Prompt: p, Validate: v, Convert: c}}, t)
-	if err != nil {
-		return err
-	}
+// PromptContext is implemented by Prompt types that can honor cancellation
+// of the context.Context passed to AskContext/AskOneContext while they wait
+// for input. Ask/AskContext use it instead of Prompt() whenever the prompt
+// doesn't also implement PromptWithOpts, which takes precedence.
+type PromptContext interface {
+	PromptContext(ctx context.Context) (interface{}, error)
+}
 
-	return nil
+// AskOne asks a single question, additionally configured by opts, without
+// performing validation on the answer beyond what v and opts describe.
+func AskOne(p Prompt, t interface{}, v Validator, c Converter, opts ...AskOpt) error {
+	return AskOneContext(context.Background(), p, t, v, c, opts...)
+}
+
+// AskOneContext is like AskOne, but stops once ctx is done, in the way
+// AskContext describes.
+func AskOneContext(ctx context.Context, p Prompt, t interface{}, v Validator, c Converter, opts ...AskOpt) error {
+	return AskContext(ctx, []*Question{{Prompt: p, Validate: v, Convert: c}}, t, opts...)
 }
 
-// Ask performs the prompt loop
-func Ask(qs []*Question, t interface{}) error {
+// Ask performs the prompt loop, applying any AskOpts to every question in
+// qs. Questions are visited in order, and each one's Skip/When/Default are
+// evaluated against the answers already written for the earlier questions
+// in qs, not the final answers for all of qs.
+func Ask(qs []*Question, t interface{}, opts ...AskOpt) error {
+	return AskContext(context.Background(), qs, t, opts...)
+}
+
+// AskContext is like Ask, but stops once ctx is done, returning ctx.Err(),
+// or ErrInterrupt instead if the process received SIGINT while a question
+// was outstanding (SIGINT cancels ctx; it restores no terminal state). ctx
+// is checked before every read. A read already in progress is aborted only
+// for a Prompt that implements PromptContext and not PromptWithOpts; any
+// other Prompt, including every Prompt type in this package, finishes that
+// read first.
+func AskContext(ctx context.Context, qs []*Question, t interface{}, opts ...AskOpt) (err error) {
 
 	// if we weren't passed a place to record the answers
 	if t == nil {
@@ -47,10 +94,85 @@ func Ask(qs []*Question, t interface{}) error {
 		return errors.New("cannot call Ask() with a nil reference to record the answers")
 	}
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	options := askOptions{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var interrupted int32
+	unregister := RegisterCleaner(func() {
+		atomic.StoreInt32(&interrupted, 1)
+		cancel()
+	})
+	defer unregister()
+	defer func() {
+		// SIGINT takes precedence over whatever error the aborted prompt
+		// happened to surface
+		if atomic.LoadInt32(&interrupted) == 1 && err != nil {
+			err = ErrInterrupt
+		}
+	}()
+
 	// go over every question
 	for _, q := range qs {
+		answers := currentAnswers(t)
+
+		if q.Skip != nil {
+			if err := q.Skip(answers); err != nil {
+				return err
+			}
+		}
+
+		// When returning false records no answer for this question and
+		// moves on to the next one
+		if q.When != nil && !q.When(answers) {
+			continue
+		}
+
+		if q.Default != nil {
+			if ds, ok := q.Prompt.(DefaultSetter); ok {
+				ds.SetDefault(q.Default(answers))
+			}
+		}
+
+		convert := q.Convert
+		if convert == nil {
+			convert = options.Converter
+		}
+
+		validators := options.Validators
+		if q.Validate != nil {
+			validators = append([]Validator{q.Validate}, validators...)
+		}
+		validate := ComposeValidators(validators...)
+
+		// see if one of the answer sources already satisfies this
+		// question before ever prompting for it
+		if sourced, ok := lookupSource(q.Name, options.Sources, convert, validate); ok {
+			if err := core.WriteAnswer(t, q.Name, sourced); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if options.NonInteractive {
+			return fmt.Errorf("no answer source satisfied required question %q", q.Name)
+		}
+
 		// grab the user input and save it
-		ans, err := q.Prompt.Prompt()
+		ans, err := promptForAnswer(ctx, q.Prompt, options)
 		convertedAns := ans
 		// if there was a problem
 		if err != nil {
@@ -58,11 +180,11 @@ func Ask(qs []*Question, t interface{}) error {
 		}
 
 		// if there's a converter
-		if q.Convert != nil {
+		if convert != nil {
 			var invalid error
 
 			// wait for a valid response
-			for convertedAns, invalid = q.Convert(ans); invalid != nil; convertedAns, invalid = q.Convert(ans) {
+			for convertedAns, invalid = convert(ans); invalid != nil; convertedAns, invalid = convert(ans) {
 				err := q.Prompt.Error(invalid)
 				// if there was a problem
 				if err != nil {
@@ -70,7 +192,7 @@ func Ask(qs []*Question, t interface{}) error {
 				}
 
 				// ask for more input
-				ans, err = q.Prompt.Prompt()
+				ans, err = promptForAnswer(ctx, q.Prompt, options)
 				// if there was a problem
 				if err != nil {
 					return err
@@ -78,21 +200,40 @@ func Ask(qs []*Question, t interface{}) error {
 			}
 		}
 
-		// if there is a validate handler for this question
-		if q.Validate != nil {
+		// if there are validate handlers for this question
+		if len(validators) > 0 {
 			// wait for a valid response
-			for invalid := q.Validate(convertedAns); invalid != nil; invalid = q.Validate(convertedAns) {
+			for invalid := validate(convertedAns); invalid != nil; invalid = validate(convertedAns) {
 				err := q.Prompt.Error(invalid)
 				// if there was a problem
 				if err != nil {
 					return err
 				}
 
-				// ask for more input
-				ans, err = q.Prompt.Prompt()
-				// if there was a problem
-				if err != nil {
-					return err
+				// ask for more input, running it through the same
+				// conversion as the first answer before validate sees it -
+				// and, like the convert loop above, reprompting again if
+				// the retry itself fails to convert - or the loop here
+				// would keep re-validating the answer that just failed
+				for {
+					ans, err = promptForAnswer(ctx, q.Prompt, options)
+					// if there was a problem
+					if err != nil {
+						return err
+					}
+
+					convertedAns = ans
+					if convert == nil {
+						break
+					}
+					var convErr error
+					convertedAns, convErr = convert(ans)
+					if convErr == nil {
+						break
+					}
+					if err := q.Prompt.Error(convErr); err != nil {
+						return err
+					}
 				}
 			}
 		}
@@ -117,3 +258,69 @@ func Ask(qs []*Question, t interface{}) error {
 	// return the response
 	return nil
 }
+
+// promptForAnswer returns ctx.Err() without reading if ctx is already done.
+// Otherwise it prefers p's PromptWithOpts method, so that options like
+// WithStdio/WithIcons/WithPageSize actually reach the prompt, falling back
+// to PromptContext so that a cancelled ctx aborts the read instead of
+// blocking forever, and finally to the plain Prompt() for prompts that
+// implement neither.
+func promptForAnswer(ctx context.Context, p Prompt, options askOptions) (interface{}, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if pwo, ok := p.(PromptWithOpts); ok {
+		return pwo.PromptWithOpts(options)
+	}
+
+	if pc, ok := p.(PromptContext); ok {
+		return pc.PromptContext(ctx)
+	}
+
+	return p.Prompt()
+}
+
+// currentAnswers returns the answers recorded so far in t, dereferencing a
+// pointer so that a later Question's When/Default sees the struct or map
+// value rather than a pointer to it.
+func currentAnswers(t interface{}) interface{} {
+	v := reflect.ValueOf(t)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+// lookupSource consults sources in order for an answer to name, running it
+// through convert and validate. The first source to produce a value that
+// converts and validates cleanly wins.
+func lookupSource(name string, sources []AnswerSource, convert Converter, validate Validator) (interface{}, bool) {
+	for _, source := range sources {
+		raw, ok := source.Lookup(name)
+		if !ok {
+			continue
+		}
+
+		var ans interface{} = raw
+		if convert != nil {
+			converted, err := convert(raw)
+			if err != nil {
+				continue
+			}
+			ans = converted
+		}
+
+		if validate != nil {
+			if err := validate(ans); err != nil {
+				continue
+			}
+		}
+
+		return ans, true
+	}
+
+	return nil, false
+}
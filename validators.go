@@ -0,0 +1,209 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+)
+
+// ComposeValidators combines multiple validators into a single Validator.
+// Each one is run in order against the answer; the first one to return an
+// error short-circuits the rest, and that error is returned.
+func ComposeValidators(vs ...Validator) Validator {
+	return func(ans interface{}) error {
+		for _, v := range vs {
+			if err := v(ans); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// AnyValidator combines multiple validators into a single Validator that
+// passes as soon as one of vs passes. If none of them pass, the error from
+// the last one is returned.
+func AnyValidator(vs ...Validator) Validator {
+	return func(ans interface{}) error {
+		var err error
+		for _, v := range vs {
+			if err = v(ans); err == nil {
+				return nil
+			}
+		}
+		return err
+	}
+}
+
+// answerLength returns the length of ans: the rune count of a string, the
+// element count of a slice (e.g. the selected indices of a MultiSelect), or
+// 1 for a scalar answer such as the bool from a Confirm or a number produced
+// by a Converter. A scalar always has "length" 1 because,
+// unlike a string or slice, it has no empty value distinct from its zero
+// value (false/0 is just as much an answer as true/1).
+func answerLength(ans interface{}) int {
+	if s, ok := ans.(string); ok {
+		return len([]rune(s))
+	}
+
+	value := reflect.ValueOf(ans)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len()
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return 1
+	}
+	return 0
+}
+
+// Required rejects an empty answer.
+func Required(ans interface{}) error {
+	if answerLength(ans) == 0 {
+		return fmt.Errorf("value is required")
+	}
+	return nil
+}
+
+// MinLength rejects an answer shorter than n.
+func MinLength(n int) Validator {
+	return func(ans interface{}) error {
+		if answerLength(ans) < n {
+			return fmt.Errorf("value is too short, minimum length is %d", n)
+		}
+		return nil
+	}
+}
+
+// MaxLength rejects an answer longer than n.
+func MaxLength(n int) Validator {
+	return func(ans interface{}) error {
+		if answerLength(ans) > n {
+			return fmt.Errorf("value is too long, maximum length is %d", n)
+		}
+		return nil
+	}
+}
+
+// MinItems rejects a MultiSelect answer with fewer than n selections.
+func MinItems(n int) Validator {
+	return func(ans interface{}) error {
+		if answerLength(ans) < n {
+			return fmt.Errorf("at least %d choices are required", n)
+		}
+		return nil
+	}
+}
+
+// MaxItems rejects a MultiSelect answer with more than n selections.
+func MaxItems(n int) Validator {
+	return func(ans interface{}) error {
+		if answerLength(ans) > n {
+			return fmt.Errorf("at most %d choices are allowed", n)
+		}
+		return nil
+	}
+}
+
+// Match rejects an answer that doesn't match re, reporting msg as the
+// reason.
+func Match(re *regexp.Regexp, msg string) Validator {
+	return func(ans interface{}) error {
+		s, ok := ans.(string)
+		if !ok {
+			return fmt.Errorf("unable to match against a non string value")
+		}
+		if !re.MatchString(s) {
+			return errors.New(msg)
+		}
+		return nil
+	}
+}
+
+var emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// IsEmail rejects an answer that doesn't look like an email address.
+func IsEmail(ans interface{}) error {
+	return Match(emailPattern, "value must be a valid email address")(ans)
+}
+
+var urlPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://[^\s]+$`)
+
+// IsURL rejects an answer that doesn't look like a URL.
+func IsURL(ans interface{}) error {
+	return Match(urlPattern, "value must be a valid URL")(ans)
+}
+
+var intPattern = regexp.MustCompile(`^[-+]?[0-9]+$`)
+
+// IsInt rejects an answer that isn't a whole number.
+func IsInt(ans interface{}) error {
+	return Match(intPattern, "value must be an integer")(ans)
+}
+
+var floatPattern = regexp.MustCompile(`^[-+]?[0-9]*\.?[0-9]+$`)
+
+// IsFloat rejects an answer that isn't a decimal number.
+func IsFloat(ans interface{}) error {
+	return Match(floatPattern, "value must be a number")(ans)
+}
+
+// Range rejects an answer that isn't a string holding a number within
+// [min, max]. An answer that is already numeric (e.g. produced by a
+// Converter) is rejected as well.
+func Range(min, max float64) Validator {
+	return func(ans interface{}) error {
+		s, ok := ans.(string)
+		if !ok {
+			return fmt.Errorf("unable to range check a non string value")
+		}
+
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("value must be a number")
+		}
+		if f < min || f > max {
+			return fmt.Errorf("value must be between %g and %g", min, max)
+		}
+		return nil
+	}
+}
+
+// OneOf rejects an answer that isn't one of vals.
+func OneOf(vals ...string) Validator {
+	return func(ans interface{}) error {
+		s, ok := ans.(string)
+		if !ok {
+			return fmt.Errorf("unable to check a non string value")
+		}
+		for _, val := range vals {
+			if s == val {
+				return nil
+			}
+		}
+		return fmt.Errorf("value must be one of %v", vals)
+	}
+}
+
+// Unique rejects a MultiSelect answer that contains the same index more
+// than once.
+func Unique(ans interface{}) error {
+	value := reflect.ValueOf(ans)
+	if value.Kind() != reflect.Slice {
+		return fmt.Errorf("unable to check uniqueness of a non slice value")
+	}
+
+	seen := make(map[interface{}]bool, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		v := value.Index(i).Interface()
+		if seen[v] {
+			return fmt.Errorf("duplicate choice found")
+		}
+		seen[v] = true
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package survey
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestComposeValidatorsShortCircuitsOnFirstFailure(t *testing.T) {
+	calls := 0
+	passes := func(interface{}) error {
+		calls++
+		return nil
+	}
+	fails := func(interface{}) error {
+		calls++
+		return errString("nope")
+	}
+
+	err := ComposeValidators(passes, fails, passes)("anything")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 2 {
+		t.Fatalf("expected the validator after the failure to be skipped, got %d calls", calls)
+	}
+}
+
+func TestAnyValidatorPassesIfOnePasses(t *testing.T) {
+	err := AnyValidator(Required, OneOf("a", "b"))("b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAnyValidatorFailsIfNonePass(t *testing.T) {
+	if err := AnyValidator(OneOf("a"), OneOf("b"))("c"); err == nil {
+		t.Fatal("expected an error when no validator passes")
+	}
+}
+
+func TestRequiredAcceptsScalarZeroValuesButRejectsEmpty(t *testing.T) {
+	// a bool/number answer is always "present", even when it's the zero
+	// value, since false/0 is a real answer rather than a missing one
+	if err := Required(false); err != nil {
+		t.Errorf("expected Required to accept a bool answer, got %v", err)
+	}
+	if err := Required(0); err != nil {
+		t.Errorf("expected Required to accept a numeric answer, got %v", err)
+	}
+	if err := Required(""); err == nil {
+		t.Error("expected Required to reject an empty string")
+	}
+	if err := Required([]int{}); err == nil {
+		t.Error("expected Required to reject an empty slice")
+	}
+}
+
+func TestMaxLengthTreatsAScalarAnswerAsLengthOne(t *testing.T) {
+	if err := MaxLength(1)(true); err != nil {
+		t.Errorf("expected a bool answer to satisfy MaxLength(1), got %v", err)
+	}
+	if err := MaxLength(0)(true); err == nil {
+		t.Error("expected a bool answer to fail MaxLength(0)")
+	}
+}
+
+func TestMatchUsesMsgAsLiteralText(t *testing.T) {
+	err := Match(regexp.MustCompile(`^never$`), "must match 100% of pattern")("nope")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if err.Error() != "must match 100% of pattern" {
+		t.Fatalf("expected msg to be used verbatim, got %q", err.Error())
+	}
+}
+
+func TestRangeRejectsTrailingGarbage(t *testing.T) {
+	if err := Range(0, 100)("12abc"); err == nil {
+		t.Fatal("expected Range to reject a value with a non-numeric suffix")
+	}
+}
+
+func TestRangeAcceptsWholeNumberInBounds(t *testing.T) {
+	if err := Range(0, 100)("42"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUniqueRejectsDuplicates(t *testing.T) {
+	if err := Unique([]int{0, 1, 0}); err == nil {
+		t.Fatal("expected an error for a duplicate choice")
+	}
+	if err := Unique([]int{0, 1, 2}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+type errString string
+
+func (e errString) Error() string { return string(e) }